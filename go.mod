@@ -9,6 +9,7 @@ require (
 	github.com/UnnoTed/fileb0x v1.1.4
 	github.com/go-chi/chi v4.1.0+incompatible
 	github.com/micro/cli/v2 v2.1.1
+	github.com/micro/go-micro/v2 v2.0.0
 	github.com/oklog/run v1.0.0
 	github.com/openzipkin/zipkin-go v0.2.2
 	github.com/owncloud/ocis-pkg/v2 v2.2.0
@@ -2,9 +2,11 @@ package command
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 
 	"contrib.go.opencensus.io/exporter/jaeger"
@@ -16,6 +18,8 @@ import (
 	zipkinhttp "github.com/openzipkin/zipkin-go/reporter/http"
 	"github.com/owncloud/ocis-store/pkg/config"
 	"github.com/owncloud/ocis-store/pkg/flagset"
+	"github.com/owncloud/ocis-store/pkg/inflight"
+	"github.com/owncloud/ocis-store/pkg/maintenance"
 	"github.com/owncloud/ocis-store/pkg/metrics"
 	"github.com/owncloud/ocis-store/pkg/server/debug"
 	"github.com/owncloud/ocis-store/pkg/server/http"
@@ -126,13 +130,39 @@ func Server(cfg *config.Config) *cli.Command {
 					Msg("Tracing is not enabled")
 			}
 
+			if cfg.Maintenance.ReadOnly {
+				logger.Warn().
+					Msg("Service is in read-only maintenance mode")
+			}
+
+			if cfg.Dev.ErrorRate < 0 || cfg.Dev.ErrorRate > 1 {
+				err := fmt.Errorf("dev error rate %v is not within 0 and 1", cfg.Dev.ErrorRate)
+
+				logger.Error().
+					Err(err).
+					Msg("Invalid dev configuration")
+
+				return err
+			}
+
+			if cfg.Dev.Latency > 0 || cfg.Dev.ErrorRate > 0 {
+				logger.Warn().
+					Dur("latency", cfg.Dev.Latency).
+					Float64("rate", cfg.Dev.ErrorRate).
+					Msg("Simulating latency and failures, do not use in production")
+			}
+
 			var (
-				gr          = run.Group{}
-				ctx, cancel = context.WithCancel(context.Background())
-				metrics     = metrics.New()
+				gr              = run.Group{}
+				ctx, cancel     = context.WithCancel(context.Background())
+				draining, drain = context.WithCancel(ctx)
+				metrics         = metrics.New()
+				maintenance     = maintenance.New(cfg.Maintenance.ReadOnly)
+				inflight        = inflight.New()
 			)
 
 			defer cancel()
+			defer drain()
 
 			{
 				server, err := http.Server(
@@ -141,6 +171,8 @@ func Server(cfg *config.Config) *cli.Command {
 					http.Context(ctx),
 					http.Config(cfg),
 					http.Metrics(metrics),
+					http.Maintenance(maintenance),
+					http.Inflight(inflight),
 					http.Flags(flagset.RootWithConfig(config.New())),
 					http.Flags(flagset.ServerWithConfig(config.New())),
 				)
@@ -162,14 +194,25 @@ func Server(cfg *config.Config) *cli.Command {
 						Msg("Shutting down server")
 
 					cancel()
+
+					ctx, timeout := context.WithTimeout(context.Background(), cfg.Shutdown.Timeout)
+					defer timeout()
+
+					if err := inflight.Close(ctx); err != nil {
+						logger.Error().
+							Err(err).
+							Str("server", "http").
+							Msg("Failed to drain running requests")
+					}
 				})
 			}
 
 			{
 				server, err := debug.Server(
 					debug.Logger(logger),
-					debug.Context(ctx),
+					debug.Context(draining),
 					debug.Config(cfg),
+					debug.Maintenance(maintenance),
 				)
 
 				if err != nil {
@@ -184,7 +227,7 @@ func Server(cfg *config.Config) *cli.Command {
 				gr.Add(func() error {
 					return server.ListenAndServe()
 				}, func(_ error) {
-					ctx, timeout := context.WithTimeout(ctx, 5*time.Second)
+					ctx, timeout := context.WithTimeout(context.Background(), 5*time.Second)
 
 					defer timeout()
 					defer cancel()
@@ -206,12 +249,26 @@ func Server(cfg *config.Config) *cli.Command {
 				stop := make(chan os.Signal, 1)
 
 				gr.Add(func() error {
-					signal.Notify(stop, os.Interrupt)
+					signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+
+					if _, ok := <-stop; !ok {
+						return nil
+					}
+
+					drain()
 
-					<-stop
+					logger.Info().
+						Dur("grace", cfg.Shutdown.Grace).
+						Msg("Reporting not ready before shutting down")
+
+					select {
+					case <-time.After(cfg.Shutdown.Grace):
+					case <-stop:
+					}
 
 					return nil
 				}, func(err error) {
+					signal.Stop(stop)
 					close(stop)
 					cancel()
 				})
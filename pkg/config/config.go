@@ -1,5 +1,9 @@
 package config
 
+import (
+	"time"
+)
+
 // Log defines the available logging configuration.
 type Log struct {
 	Level  string
@@ -31,13 +35,40 @@ type Tracing struct {
 	Service   string
 }
 
+// Deadline defines the available deadline configuration.
+type Deadline struct {
+	Read  time.Duration
+	Write time.Duration
+}
+
+// Shutdown defines the available shutdown configuration.
+type Shutdown struct {
+	Grace   time.Duration
+	Timeout time.Duration
+}
+
+// Maintenance defines the available maintenance configuration.
+type Maintenance struct {
+	ReadOnly bool
+}
+
+// Dev defines the available development configuration.
+type Dev struct {
+	Latency   time.Duration
+	ErrorRate float64
+}
+
 // Config combines all available configuration parts.
 type Config struct {
-	File    string
-	Log     Log
-	Debug   Debug
-	HTTP    HTTP
-	Tracing Tracing
+	File        string
+	Log         Log
+	Debug       Debug
+	HTTP        HTTP
+	Tracing     Tracing
+	Deadline    Deadline
+	Shutdown    Shutdown
+	Maintenance Maintenance
+	Dev         Dev
 }
 
 // New initializes a new configuration with or without defaults.
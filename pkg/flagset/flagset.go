@@ -1,6 +1,8 @@
 package flagset
 
 import (
+	"time"
+
 	"github.com/micro/cli/v2"
 	"github.com/owncloud/ocis-store/pkg/config"
 )
@@ -136,5 +138,53 @@ func ServerWithConfig(cfg *config.Config) []cli.Flag {
 			EnvVars:     []string{"STORE_HTTP_ROOT"},
 			Destination: &cfg.HTTP.Root,
 		},
+		&cli.DurationFlag{
+			Name:        "deadline-read",
+			Value:       10 * time.Second,
+			Usage:       "Default deadline for read requests",
+			EnvVars:     []string{"STORE_DEADLINE_READ"},
+			Destination: &cfg.Deadline.Read,
+		},
+		&cli.DurationFlag{
+			Name:        "deadline-write",
+			Value:       time.Minute,
+			Usage:       "Default deadline for write requests",
+			EnvVars:     []string{"STORE_DEADLINE_WRITE"},
+			Destination: &cfg.Deadline.Write,
+		},
+		&cli.DurationFlag{
+			Name:        "shutdown-grace",
+			Value:       0,
+			Usage:       "Time to report not ready before closing the listeners on shutdown",
+			EnvVars:     []string{"STORE_SHUTDOWN_GRACE"},
+			Destination: &cfg.Shutdown.Grace,
+		},
+		&cli.DurationFlag{
+			Name:        "shutdown-timeout",
+			Value:       5 * time.Second,
+			Usage:       "Time to wait for running requests on shutdown",
+			EnvVars:     []string{"STORE_SHUTDOWN_TIMEOUT"},
+			Destination: &cfg.Shutdown.Timeout,
+		},
+		&cli.BoolFlag{
+			Name:        "maintenance-read-only",
+			Usage:       "Reject all write requests",
+			EnvVars:     []string{"STORE_MAINTENANCE_READ_ONLY"},
+			Destination: &cfg.Maintenance.ReadOnly,
+		},
+		&cli.DurationFlag{
+			Name:        "dev-latency",
+			Value:       0,
+			Usage:       "Artificial latency added to every request",
+			EnvVars:     []string{"STORE_DEV_LATENCY"},
+			Destination: &cfg.Dev.Latency,
+		},
+		&cli.Float64Flag{
+			Name:        "dev-error-rate",
+			Value:       0,
+			Usage:       "Share of requests failing with a simulated error, between 0 and 1",
+			EnvVars:     []string{"STORE_DEV_ERROR_RATE"},
+			Destination: &cfg.Dev.ErrorRate,
+		},
 	}
 }
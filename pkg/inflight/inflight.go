@@ -0,0 +1,59 @@
+package inflight
+
+import (
+	"context"
+	"sync"
+)
+
+// Tracker keeps track of the requests currently being served.
+type Tracker struct {
+	mu     sync.Mutex
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// New initializes a new request tracker.
+func New() *Tracker {
+	return &Tracker{}
+}
+
+// Begin registers a new request, it returns false once the tracker got
+// closed and the request should be rejected.
+func (t *Tracker) Begin() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		return false
+	}
+
+	t.wg.Add(1)
+	return true
+}
+
+// End marks a request registered by Begin as done.
+func (t *Tracker) End() {
+	t.wg.Done()
+}
+
+// Close rejects all further requests and waits until the running requests
+// are done or the context expires.
+func (t *Tracker) Close(ctx context.Context) error {
+	t.mu.Lock()
+	t.closed = true
+	t.mu.Unlock()
+
+	done := make(chan struct{})
+
+	go func() {
+		t.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,34 @@
+package maintenance
+
+import (
+	"sync/atomic"
+)
+
+// Mode defines the runtime maintenance state, it is safe for concurrent use.
+type Mode struct {
+	readOnly int32
+}
+
+// New initializes the maintenance mode with the given read-only state.
+func New(readOnly bool) *Mode {
+	m := &Mode{}
+	m.SetReadOnly(readOnly)
+
+	return m
+}
+
+// ReadOnly reports whether write requests get rejected.
+func (m *Mode) ReadOnly() bool {
+	return atomic.LoadInt32(&m.readOnly) == 1
+}
+
+// SetReadOnly enables or disables the read-only mode.
+func (m *Mode) SetReadOnly(val bool) {
+	var v int32
+
+	if val {
+		v = 1
+	}
+
+	atomic.StoreInt32(&m.readOnly, v)
+}
@@ -0,0 +1,61 @@
+package debug
+
+import (
+	"crypto/subtle"
+	"io"
+	"net/http"
+
+	"github.com/owncloud/ocis-pkg/v2/log"
+	"github.com/owncloud/ocis-store/pkg/config"
+	"github.com/owncloud/ocis-store/pkg/maintenance"
+)
+
+// readOnly implements the runtime switch for the read-only maintenance mode.
+// PUT enables and DELETE disables the mode, GET reports the current state.
+// The endpoint requires the debug token and is disabled without one.
+func readOnly(cfg *config.Config, logger log.Logger, mode *maintenance.Mode) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+
+		if cfg.Debug.Token == "" {
+			w.WriteHeader(http.StatusForbidden)
+			io.WriteString(w, "Maintenance endpoint requires a debug token")
+			return
+		}
+
+		token := []byte("Bearer " + cfg.Debug.Token)
+
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), token) != 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			io.WriteString(w, http.StatusText(http.StatusUnauthorized))
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			mode.SetReadOnly(true)
+
+			logger.Warn().
+				Msg("Enabled read-only maintenance mode")
+		case http.MethodDelete:
+			mode.SetReadOnly(false)
+
+			logger.Info().
+				Msg("Disabled read-only maintenance mode")
+		default:
+			w.Header().Set("Allow", "GET, PUT, DELETE")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			io.WriteString(w, http.StatusText(http.StatusMethodNotAllowed))
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+
+		if mode.ReadOnly() {
+			io.WriteString(w, "read-only")
+		} else {
+			io.WriteString(w, "read-write")
+		}
+	}
+}
@@ -3,8 +3,9 @@ package debug
 import (
 	"context"
 
-	"github.com/owncloud/ocis-store/pkg/config"
 	"github.com/owncloud/ocis-pkg/v2/log"
+	"github.com/owncloud/ocis-store/pkg/config"
+	"github.com/owncloud/ocis-store/pkg/maintenance"
 )
 
 // Option defines a single option function.
@@ -12,9 +13,10 @@ type Option func(o *Options)
 
 // Options defines the available options for this package.
 type Options struct {
-	Logger  log.Logger
-	Context context.Context
-	Config  *config.Config
+	Logger      log.Logger
+	Context     context.Context
+	Config      *config.Config
+	Maintenance *maintenance.Mode
 }
 
 // newOptions initializes the available default options.
@@ -49,3 +51,9 @@ func Config(val *config.Config) Option {
 	}
 }
 
+// Maintenance provides a function to set the maintenance option.
+func Maintenance(val *maintenance.Mode) Option {
+	return func(o *Options) {
+		o.Maintenance = val
+	}
+}
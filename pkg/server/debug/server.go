@@ -1,6 +1,7 @@
 package debug
 
 import (
+	"context"
 	"io"
 	"net/http"
 
@@ -13,7 +14,7 @@ import (
 func Server(opts ...Option) (*http.Server, error) {
 	options := newOptions(opts...)
 
-	return debug.NewService(
+	server := debug.NewService(
 		debug.Logger(options.Logger),
 		debug.Name("store"),
 		debug.Version(version.String),
@@ -22,8 +23,19 @@ func Server(opts ...Option) (*http.Server, error) {
 		debug.Pprof(options.Config.Debug.Pprof),
 		debug.Zpages(options.Config.Debug.Zpages),
 		debug.Health(health(options.Config)),
-		debug.Ready(ready(options.Config)),
-	), nil
+		debug.Ready(ready(options.Context, options.Config)),
+	)
+
+	if options.Maintenance != nil {
+		mux := http.NewServeMux()
+
+		mux.Handle("/", server.Handler)
+		mux.HandleFunc("/maintenance", readOnly(options.Config, options.Logger, options.Maintenance))
+
+		server.Handler = mux
+	}
+
+	return server, nil
 }
 
 // health implements the health check.
@@ -38,14 +50,23 @@ func health(cfg *config.Config) func(http.ResponseWriter, *http.Request) {
 	}
 }
 
-// ready implements the ready check.
-func ready(cfg *config.Config) func(http.ResponseWriter, *http.Request) {
+// ready implements the ready check, it reports the service as unavailable
+// as soon as the server context is done.
+func ready(ctx context.Context, cfg *config.Config) func(http.ResponseWriter, *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "text/plain")
-		w.WriteHeader(http.StatusOK)
 
-		// TODO(tboerger): check if services are up and running
+		if ctx != nil {
+			select {
+			case <-ctx.Done():
+				w.WriteHeader(http.StatusServiceUnavailable)
+				io.WriteString(w, http.StatusText(http.StatusServiceUnavailable))
+				return
+			default:
+			}
+		}
 
+		w.WriteHeader(http.StatusOK)
 		io.WriteString(w, http.StatusText(http.StatusOK))
 	}
 }
@@ -6,6 +6,8 @@ import (
 	"github.com/micro/cli/v2"
 	"github.com/owncloud/ocis-pkg/v2/log"
 	"github.com/owncloud/ocis-store/pkg/config"
+	"github.com/owncloud/ocis-store/pkg/inflight"
+	"github.com/owncloud/ocis-store/pkg/maintenance"
 	"github.com/owncloud/ocis-store/pkg/metrics"
 )
 
@@ -14,12 +16,14 @@ type Option func(o *Options)
 
 // Options defines the available options for this package.
 type Options struct {
-	Namespace string
-	Logger    log.Logger
-	Context   context.Context
-	Config    *config.Config
-	Metrics   *metrics.Metrics
-	Flags     []cli.Flag
+	Namespace   string
+	Logger      log.Logger
+	Context     context.Context
+	Config      *config.Config
+	Metrics     *metrics.Metrics
+	Maintenance *maintenance.Mode
+	Inflight    *inflight.Tracker
+	Flags       []cli.Flag
 }
 
 // newOptions initializes the available default options.
@@ -61,6 +65,20 @@ func Metrics(val *metrics.Metrics) Option {
 	}
 }
 
+// Maintenance provides a function to set the maintenance option.
+func Maintenance(val *maintenance.Mode) Option {
+	return func(o *Options) {
+		o.Maintenance = val
+	}
+}
+
+// Inflight provides a function to set the inflight option.
+func Inflight(val *inflight.Tracker) Option {
+	return func(o *Options) {
+		o.Inflight = val
+	}
+}
+
 // Flags provides a function to set the flags option.
 func Flags(val []cli.Flag) Option {
 	return func(o *Options) {
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"github.com/micro/go-micro/v2/web"
 	"github.com/owncloud/ocis-pkg/v2/middleware"
 	"github.com/owncloud/ocis-pkg/v2/service/http"
 	svc "github.com/owncloud/ocis-store/pkg/service/v0"
@@ -24,6 +25,8 @@ func Server(opts ...Option) (http.Service, error) {
 	handle := svc.NewService(
 		svc.Logger(options.Logger),
 		svc.Config(options.Config),
+		svc.Maintenance(options.Maintenance),
+		svc.Inflight(options.Inflight),
 		svc.Middleware(
 			middleware.RealIP,
 			middleware.RequestID,
@@ -51,6 +54,11 @@ func Server(opts ...Option) (http.Service, error) {
 		handle,
 	)
 
-	service.Init()
+	// Signals are handled by the server command, which shuts down the service
+	// through the context after the configured grace period.
+	service.Init(
+		web.HandleSignal(false),
+	)
+
 	return service, nil
 }
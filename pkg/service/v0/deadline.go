@@ -0,0 +1,32 @@
+package svc
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// deadline bounds every request by the read or write timeout depending on
+// the request method. A zero timeout leaves the request unbounded.
+func deadline(read, write time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			timeout := write
+
+			switch r.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				timeout = read
+			}
+
+			if timeout <= 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
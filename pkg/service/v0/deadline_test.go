@@ -0,0 +1,73 @@
+package svc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDeadline(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		read   time.Duration
+		write  time.Duration
+		want   time.Duration
+	}{
+		{
+			name:   "get uses read deadline",
+			method: http.MethodGet,
+			read:   time.Second,
+			write:  time.Minute,
+			want:   time.Second,
+		},
+		{
+			name:   "post uses write deadline",
+			method: http.MethodPost,
+			read:   time.Second,
+			write:  time.Minute,
+			want:   time.Minute,
+		},
+		{
+			name:   "zero disables deadline",
+			method: http.MethodPost,
+			read:   time.Second,
+			write:  0,
+			want:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				got time.Time
+				ok  bool
+			)
+
+			handler := deadline(tt.read, tt.write)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got, ok = r.Context().Deadline()
+			}))
+
+			before := time.Now()
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(tt.method, "/", nil))
+			after := time.Now()
+
+			if tt.want == 0 {
+				if ok {
+					t.Fatalf("expected no deadline, got %v", got)
+				}
+
+				return
+			}
+
+			if !ok {
+				t.Fatalf("expected a deadline of %v, got none", tt.want)
+			}
+
+			if got.Before(before.Add(tt.want)) || got.After(after.Add(tt.want)) {
+				t.Fatalf("expected a deadline of %v, got %v", tt.want, got.Sub(before))
+			}
+		})
+	}
+}
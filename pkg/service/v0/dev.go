@@ -0,0 +1,41 @@
+package svc
+
+import (
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// simulate delays every request by the given latency and fails the given
+// share of requests, so clients can test their timeout and retry handling.
+func simulate(latency time.Duration, rate float64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if latency <= 0 && rate <= 0 {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if latency > 0 {
+				select {
+				case <-time.After(latency):
+				case <-r.Context().Done():
+					w.Header().Set("Content-Type", "text/plain")
+					w.WriteHeader(http.StatusGatewayTimeout)
+
+					w.Write([]byte("Simulated latency exceeded the request deadline"))
+					return
+				}
+			}
+
+			if rate > 0 && rand.Float64() < rate {
+				w.Header().Set("Content-Type", "text/plain")
+				w.WriteHeader(http.StatusInternalServerError)
+
+				w.Write([]byte("Simulated failure"))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
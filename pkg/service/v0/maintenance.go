@@ -0,0 +1,35 @@
+package svc
+
+import (
+	"net/http"
+
+	"github.com/owncloud/ocis-store/pkg/maintenance"
+)
+
+// readOnly rejects all requests which could modify data while the service
+// is in read-only maintenance mode.
+func readOnly(mode *maintenance.Mode) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if mode == nil {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if !mode.ReadOnly() {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Set("Content-Type", "text/plain")
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			w.Write([]byte("Service is in read-only maintenance mode"))
+		})
+	}
+}
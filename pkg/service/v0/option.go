@@ -3,8 +3,10 @@ package svc
 import (
 	"net/http"
 
-	"github.com/owncloud/ocis-store/pkg/config"
 	"github.com/owncloud/ocis-pkg/v2/log"
+	"github.com/owncloud/ocis-store/pkg/config"
+	"github.com/owncloud/ocis-store/pkg/inflight"
+	"github.com/owncloud/ocis-store/pkg/maintenance"
 )
 
 // Option defines a single option function.
@@ -12,9 +14,11 @@ type Option func(o *Options)
 
 // Options defines the available options for this package.
 type Options struct {
-	Logger     log.Logger
-	Config     *config.Config
-	Middleware []func(http.Handler) http.Handler
+	Logger      log.Logger
+	Inflight    *inflight.Tracker
+	Config      *config.Config
+	Maintenance *maintenance.Mode
+	Middleware  []func(http.Handler) http.Handler
 }
 
 // newOptions initializes the available default options.
@@ -35,6 +39,13 @@ func Logger(val log.Logger) Option {
 	}
 }
 
+// Inflight provides a function to set the inflight option.
+func Inflight(val *inflight.Tracker) Option {
+	return func(o *Options) {
+		o.Inflight = val
+	}
+}
+
 // Config provides a function to set the config option.
 func Config(val *config.Config) Option {
 	return func(o *Options) {
@@ -42,10 +53,16 @@ func Config(val *config.Config) Option {
 	}
 }
 
+// Maintenance provides a function to set the maintenance option.
+func Maintenance(val *maintenance.Mode) Option {
+	return func(o *Options) {
+		o.Maintenance = val
+	}
+}
+
 // Middleware provides a function to set the middleware option.
 func Middleware(val ...func(http.Handler) http.Handler) Option {
 	return func(o *Options) {
 		o.Middleware = val
 	}
 }
-
@@ -19,6 +19,10 @@ func NewService(opts ...Option) Service {
 
 	m := chi.NewMux()
 	m.Use(options.Middleware...)
+	m.Use(deadline(options.Config.Deadline.Read, options.Config.Deadline.Write))
+	m.Use(shutdown(options.Inflight))
+	m.Use(readOnly(options.Maintenance))
+	m.Use(simulate(options.Config.Dev.Latency, options.Config.Dev.ErrorRate))
 
 	svc := Store{
 		config: options.Config,
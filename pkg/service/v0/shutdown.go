@@ -0,0 +1,32 @@
+package svc
+
+import (
+	"net/http"
+
+	"github.com/owncloud/ocis-store/pkg/inflight"
+)
+
+// shutdown tracks all running requests so they can be drained on shutdown,
+// and rejects new requests once the tracker got closed.
+func shutdown(tracker *inflight.Tracker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if tracker == nil {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !tracker.Begin() {
+				w.Header().Set("Connection", "close")
+				w.Header().Set("Content-Type", "text/plain")
+				w.WriteHeader(http.StatusServiceUnavailable)
+
+				w.Write([]byte("Service is shutting down"))
+				return
+			}
+
+			defer tracker.End()
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
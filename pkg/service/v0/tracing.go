@@ -2,22 +2,30 @@ package svc
 
 import (
 	"net/http"
+
+	"go.opencensus.io/plugin/ochttp"
+	"go.opencensus.io/plugin/ochttp/propagation/b3"
 )
 
 // NewTracing returns a service that instruments traces.
 func NewTracing(next Service) Service {
 	return tracing{
 		next: next,
+		handler: &ochttp.Handler{
+			Handler:     next,
+			Propagation: &b3.HTTPFormat{},
+		},
 	}
 }
 
 type tracing struct {
-	next Service
+	next    Service
+	handler http.Handler
 }
 
 // ServeHTTP implements the Service interface.
 func (t tracing) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	t.next.ServeHTTP(w, r)
+	t.handler.ServeHTTP(w, r)
 }
 
 // Dummy implements the Service interface.